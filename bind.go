@@ -0,0 +1,195 @@
+package gomodel
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// A BindType selects the positional placeholder syntax Bind rewrites named
+// parameters into.
+type BindType int
+
+const (
+	// Question produces "?" placeholders, as used by MySQL and SQLite.
+	Question BindType = iota
+	// Dollar produces "$1", "$2", ... placeholders, as used by Postgres.
+	Dollar
+	// Named produces ":1", ":2", ... placeholders, as used by Oracle.
+	Named
+	// At produces "@p1", "@p2", ... placeholders, as used by SQL Server.
+	At
+)
+
+// Dialect controls the placeholder syntax Bind produces. It defaults to
+// Question.
+var Dialect = Question
+
+// Bind rewrites the `:name`-style named parameters in query into positional
+// placeholders for Dialect, and returns the corresponding argument list
+// extracted from arg in the same order as the rewritten placeholders.
+//
+// arg may be a struct (or pointer to one), resolved field-by-field using the
+// same `sql` tag and NameMapper conventions as Scan, or a
+// map[string]interface{} keyed by parameter name.
+func Bind(query string, arg interface{}) (string, []interface{}, error) {
+	rewritten, names := bindNamed(query)
+
+	values := make([]interface{}, len(names))
+
+	if m, ok := arg.(map[string]interface{}); ok {
+		for i, name := range names {
+			v, ok := m[name]
+			if !ok {
+				return "", nil, fmt.Errorf("gomodel: missing bind value for :%s", name)
+			}
+			values[i] = v
+		}
+
+		return rewritten, values, nil
+	}
+
+	rv := reflect.ValueOf(arg)
+	switch rv.Kind() {
+	case reflect.Ptr:
+		rv = rv.Elem()
+	case reflect.Struct:
+		// fieldByIndex may need to allocate nested/embedded pointer fields
+		// along the way, which requires an addressable value -- arg passed
+		// by value otherwise isn't one.
+		addressable := reflect.New(rv.Type()).Elem()
+		addressable.Set(rv)
+		rv = addressable
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return "", nil, ErrNotStruct
+	}
+
+	fields := fieldsFor(rv.Type())
+	for i, name := range names {
+		info, ok := fields[name]
+		if !ok {
+			return "", nil, fmt.Errorf("gomodel: missing bind value for :%s", name)
+		}
+
+		values[i] = fieldByIndex(rv, info.index).Interface()
+	}
+
+	return rewritten, values, nil
+}
+
+// bindNamed scans query for `:name` parameters (a leading "::" is left
+// alone, so Postgres-style type casts pass through untouched) and rewrites
+// each into the placeholder Dialect produces, returning the rewritten query
+// and the parameter names in the order they were encountered.
+func bindNamed(query string) (string, []string) {
+	var out strings.Builder
+	var names []string
+
+	inString := false
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+
+		if c == '\'' {
+			inString = !inString
+			out.WriteByte(c)
+			continue
+		}
+
+		if inString || c != ':' || i+1 >= len(query) || query[i+1] == ':' {
+			out.WriteByte(c)
+			if c == ':' && i+1 < len(query) && query[i+1] == ':' {
+				out.WriteByte(':')
+				i++
+			}
+			continue
+		}
+
+		if !isNameStart(query[i+1]) {
+			out.WriteByte(c)
+			continue
+		}
+
+		j := i + 1
+		for j < len(query) && isNameChar(query[j]) {
+			j++
+		}
+
+		names = append(names, query[i+1:j])
+		out.WriteString(placeholder(len(names)))
+		i = j - 1
+	}
+
+	return out.String(), names
+}
+
+func placeholder(position int) string {
+	switch Dialect {
+	case Dollar:
+		return "$" + strconv.Itoa(position)
+	case Named:
+		return ":" + strconv.Itoa(position)
+	case At:
+		return "@p" + strconv.Itoa(position)
+	default:
+		return "?"
+	}
+}
+
+func isNameStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isNameChar(c byte) bool {
+	return isNameStart(c) || (c >= '0' && c <= '9')
+}
+
+// In expands each "?" in query whose corresponding arg is a slice into a
+// "?,?,?"-style placeholder list of that slice's length, flattening its
+// elements into the returned argument list. Non-slice args (and []byte,
+// which drivers treat as a scalar) pass through unchanged.
+func In(query string, args ...interface{}) (string, []interface{}, error) {
+	var out strings.Builder
+	var expanded []interface{}
+
+	argi := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		if c != '?' {
+			out.WriteByte(c)
+			continue
+		}
+
+		if argi >= len(args) {
+			return "", nil, fmt.Errorf("gomodel: not enough arguments for query")
+		}
+
+		arg := args[argi]
+		argi++
+
+		v := reflect.ValueOf(arg)
+		if v.Kind() != reflect.Slice || v.Type().Elem().Kind() == reflect.Uint8 {
+			out.WriteByte('?')
+			expanded = append(expanded, arg)
+			continue
+		}
+
+		n := v.Len()
+		if n == 0 {
+			return "", nil, fmt.Errorf("gomodel: empty slice passed to In")
+		}
+
+		out.WriteString(strings.TrimSuffix(strings.Repeat("?,", n), ","))
+		for j := 0; j < n; j++ {
+			expanded = append(expanded, v.Index(j).Interface())
+		}
+	}
+
+	if argi != len(args) {
+		return "", nil, fmt.Errorf("gomodel: too many arguments for query")
+	}
+
+	return out.String(), expanded, nil
+}
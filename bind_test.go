@@ -0,0 +1,158 @@
+package gomodel
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestBindStruct(t *testing.T) {
+	type params struct {
+		ID    int64
+		Title string `sql:"title"`
+	}
+
+	query, args, err := Bind("SELECT * FROM posts WHERE id = :id AND title = :title", params{ID: 1234, Title: "hello"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts WHERE id = ? AND title = ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+
+	if want := []interface{}{int64(1234), "hello"}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want: %#v, got: %#v", want, args)
+	}
+}
+
+func TestBindStructValueWithNestedPointerField(t *testing.T) {
+	type Page struct {
+		Limit int64
+	}
+
+	type params struct {
+		Page *Page
+	}
+
+	query, args, err := Bind("SELECT * FROM posts LIMIT :page_limit", params{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts LIMIT ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+
+	if want := []interface{}{int64(0)}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want: %#v, got: %#v", want, args)
+	}
+}
+
+func TestBindNonStruct(t *testing.T) {
+	if _, _, err := Bind("SELECT * FROM posts WHERE id = :id", 1234); err != ErrNotStruct {
+		t.Fatalf("want: %s, got: %v", ErrNotStruct, err)
+	}
+
+	if _, _, err := Bind("SELECT * FROM posts WHERE id = :id", nil); err != ErrNotStruct {
+		t.Fatalf("want: %s, got: %v", ErrNotStruct, err)
+	}
+}
+
+func TestBindMap(t *testing.T) {
+	query, args, err := Bind("SELECT * FROM posts WHERE id = :id", map[string]interface{}{"id": 1234})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts WHERE id = ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+
+	if want := []interface{}{1234}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want: %#v, got: %#v", want, args)
+	}
+}
+
+func TestBindDollar(t *testing.T) {
+	old := Dialect
+	defer func() { Dialect = old }()
+	Dialect = Dollar
+
+	query, _, err := Bind("SELECT * FROM posts WHERE id = :id AND title = :title", map[string]interface{}{"id": 1, "title": "x"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts WHERE id = $1 AND title = $2"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+}
+
+func TestBindMissingValue(t *testing.T) {
+	_, _, err := Bind("SELECT * FROM posts WHERE id = :id", map[string]interface{}{})
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	t.Logf("Reported error: %s", err)
+}
+
+func TestBindIgnoresDoubleColon(t *testing.T) {
+	query, _, err := Bind("SELECT data::text FROM posts WHERE id = :id", map[string]interface{}{"id": 1})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT data::text FROM posts WHERE id = ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+}
+
+func TestIn(t *testing.T) {
+	query, args, err := In("SELECT * FROM posts WHERE id IN (?) AND deleted = ?", []int64{1, 2, 3}, false)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts WHERE id IN (?,?,?) AND deleted = ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+
+	if want := []interface{}{int64(1), int64(2), int64(3), false}; !reflect.DeepEqual(args, want) {
+		t.Errorf("want: %#v, got: %#v", want, args)
+	}
+}
+
+func TestInByteSlicePassesThrough(t *testing.T) {
+	query, args, err := In("SELECT * FROM posts WHERE body = ?", []byte("hello"))
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if want := "SELECT * FROM posts WHERE body = ?"; query != want {
+		t.Errorf("want: %s, got: %s", want, query)
+	}
+
+	if len(args) != 1 {
+		t.Fatalf("want: %d args, got: %d", 1, len(args))
+	}
+}
+
+func TestInEmptySlice(t *testing.T) {
+	_, _, err := In("SELECT * FROM posts WHERE id IN (?)", []int64{})
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	t.Logf("Reported error: %s", err)
+}
+
+func TestInArgumentCountMismatch(t *testing.T) {
+	if _, _, err := In("SELECT * FROM posts WHERE id = ?"); err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	if _, _, err := In("SELECT * FROM posts", 1); err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+}
@@ -0,0 +1,41 @@
+package gomodel
+
+import (
+	"database/sql"
+	"reflect"
+	"sync"
+)
+
+// Converter wraps dest, the addressable field a column is scanned into, in
+// an sql.Scanner responsible for decoding that column's driver value into
+// it. Register one with RegisterConverter.
+type Converter func(dest reflect.Value) sql.Scanner
+
+var converters sync.Map // map[reflect.Type]Converter
+
+// RegisterConverter installs conv as the scanner used for every struct
+// field of type t, in place of handing the driver the field's raw address.
+// This is how callers plug in decoding for types the driver doesn't know
+// about directly -- custom time.Time layouts, JSON-encoded columns,
+// uuid.UUID from []byte, and so on.
+//
+// Register converters before the first Scan or ScanRows call involving any
+// struct type with a field of type t: a struct type's field map, including
+// whether a struct-typed field is scanned whole or traversed field-by-field,
+// is built once and cached. The Converter itself is looked up on every scan,
+// so registering conv later does take effect for fields already mapped
+// whole (such as non-struct fields), but a struct-typed field that was
+// already traversed field-by-field stays traversed.
+func RegisterConverter(t reflect.Type, conv Converter) {
+	converters.Store(t, conv)
+}
+
+// converterFor returns the registered Converter for t, if any.
+func converterFor(t reflect.Type) (Converter, bool) {
+	v, ok := converters.Load(t)
+	if !ok {
+		return nil, false
+	}
+
+	return v.(Converter), true
+}
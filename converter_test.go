@@ -0,0 +1,107 @@
+package gomodel
+
+import (
+	"database/sql"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// upperString is a stand-in for a custom type (uuid.UUID, a JSON column,
+// etc.) that needs its own decoding logic rather than a raw field address.
+type upperString string
+
+type upperStringScanner struct {
+	dest *upperString
+}
+
+func (s *upperStringScanner) Scan(src interface{}) error {
+	str, _ := src.(string)
+	*s.dest = upperString(strings.ToUpper(str))
+	return nil
+}
+
+func TestRegisterConverter(t *testing.T) {
+	typ := reflect.TypeOf(upperString(""))
+	RegisterConverter(typ, func(dest reflect.Value) sql.Scanner {
+		return &upperStringScanner{dest: dest.Addr().Interface().(*upperString)}
+	})
+	defer converters.Delete(typ)
+
+	type model struct {
+		Name upperString
+	}
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 1 {
+			t.Fatalf("want: %d dests, got: %d", 1, len(dests))
+		}
+
+		if _, ok := dests[0].(sql.Scanner); !ok {
+			t.Fatalf("want: dest to implement sql.Scanner, got: %T", dests[0])
+		}
+
+		return dests[0].(sql.Scanner).Scan("hello")
+	}
+
+	if err := Scan(scanner, []string{"name"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if mymodel.Name != "HELLO" {
+		t.Errorf("want: %s, got: %s", "HELLO", mymodel.Name)
+	}
+}
+
+// Metadata stands in for a JSON-encoded column decoded into an arbitrary
+// struct -- it has exported fields of its own, so without a registered
+// Converter it would otherwise be traversed field-by-field.
+type Metadata struct {
+	Key   string
+	Value string
+}
+
+type metadataScanner struct {
+	dest *Metadata
+}
+
+func (s *metadataScanner) Scan(src interface{}) error {
+	s.dest.Key, s.dest.Value = "decoded", "true"
+	return nil
+}
+
+func TestRegisterConverterStructFieldNotTraversed(t *testing.T) {
+	typ := reflect.TypeOf(Metadata{})
+	RegisterConverter(typ, func(dest reflect.Value) sql.Scanner {
+		return &metadataScanner{dest: dest.Addr().Interface().(*Metadata)}
+	})
+	defer converters.Delete(typ)
+
+	type model struct {
+		Meta Metadata
+	}
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 1 {
+			t.Fatalf("want: %d dests, got: %d", 1, len(dests))
+		}
+
+		if _, ok := dests[0].(sql.Scanner); !ok {
+			t.Fatalf("want: dest to implement sql.Scanner, got: %T", dests[0])
+		}
+
+		return dests[0].(sql.Scanner).Scan(`{"decoded":true}`)
+	}
+
+	if err := Scan(scanner, []string{"meta"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if mymodel.Meta != (Metadata{Key: "decoded", Value: "true"}) {
+		t.Errorf("want: %#v, got: %#v", Metadata{Key: "decoded", Value: "true"}, mymodel.Meta)
+	}
+}
@@ -0,0 +1,150 @@
+package gomodel
+
+import (
+	"database/sql"
+	"reflect"
+	"sync"
+)
+
+var scannerType = reflect.TypeOf((*sql.Scanner)(nil)).Elem()
+
+// NameMapper converts a struct field name into a column name for fields with
+// no explicit `sql` tag. It defaults to snake_case. Assign a replacement
+// before scanning any model of a given type -- once a type's field map has
+// been built it is cached, and later changes to NameMapper will not affect
+// types already seen.
+var NameMapper func(string) string = snake
+
+// fieldMap maps a column name to the field it resolves to.
+type fieldMap map[string]fieldInfo
+
+// fieldInfo describes a struct field reachable from a mapped column: its
+// index path, suitable for use with fieldByIndex, and whether it carried an
+// explicit `sql` tag rather than falling back to NameMapper.
+type fieldInfo struct {
+	index  []int
+	tagged bool
+}
+
+// typeCache holds a fieldMap per struct type, built once and reused for
+// every subsequent Scan/ScanRows call against that type.
+var typeCache sync.Map // map[reflect.Type]fieldMap
+
+// fieldsFor returns the fieldMap for t, building and caching it on first
+// use.
+func fieldsFor(t reflect.Type) fieldMap {
+	if cached, ok := typeCache.Load(t); ok {
+		return cached.(fieldMap)
+	}
+
+	fields := fieldMap{}
+	walkFields(t, nil, "", fields)
+
+	actual, _ := typeCache.LoadOrStore(t, fields)
+	return actual.(fieldMap)
+}
+
+// walkFields recursively records a column-to-index-path entry for every leaf
+// field of t. Anonymous (embedded) structs are flattened into the parent's
+// namespace, as with encoding/json. Named struct fields are instead nested
+// under a "parent_field" prefix, so a Child ChildModel field whose ChildModel
+// has a Name column resolves to "child_name".
+func walkFields(t reflect.Type, index []int, prefix string, fields fieldMap) {
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+
+		// Unexported, non-embedded fields can't be addressed.
+		if field.PkgPath != "" && !field.Anonymous {
+			continue
+		}
+
+		path := make([]int, len(index)+1)
+		copy(path, index)
+		path[len(index)] = i
+
+		ft := field.Type
+		if ft.Kind() == reflect.Ptr {
+			ft = ft.Elem()
+		}
+
+		if ft.Kind() == reflect.Struct && !isLeafField(field, ft) {
+			if field.Anonymous {
+				walkFields(ft, path, prefix, fields)
+			} else {
+				walkFields(ft, path, prefix+columnName(field)+"_", fields)
+			}
+			continue
+		}
+
+		name, tagged := columnName(field), field.Tag.Get(fieldTag) != ""
+		fields[prefix+name] = fieldInfo{index: path, tagged: tagged}
+	}
+}
+
+// isLeafField reports whether field should be scanned into directly rather
+// than traversed field-by-field. An explicit `sql` tag opts a field out of
+// traversal outright -- there'd be no way to address a tagged field
+// otherwise. Failing that, a type (or pointer to it) implementing
+// sql.Scanner, such as sql.NullString, is assumed to want the raw driver
+// value rather than having its own fields matched against columns -- as is a
+// type with a Converter registered via RegisterConverter, which has to be
+// handed the whole field rather than one of its subfields.
+func isLeafField(field reflect.StructField, ft reflect.Type) bool {
+	if field.Tag.Get(fieldTag) != "" {
+		return true
+	}
+
+	if reflect.PtrTo(ft).Implements(scannerType) {
+		return true
+	}
+
+	if _, ok := converterFor(field.Type); ok {
+		return true
+	}
+
+	if _, ok := converterFor(ft); ok {
+		return true
+	}
+
+	return isLeafStruct(ft)
+}
+
+// isLeafStruct reports whether t should be treated as a scannable value in
+// its own right rather than traversed field-by-field -- time.Time being the
+// prototypical example. A struct with no exported fields can't be traversed
+// usefully, so it's assumed to be a leaf.
+func isLeafStruct(t reflect.Type) bool {
+	for i := 0; i < t.NumField(); i++ {
+		if t.Field(i).PkgPath == "" {
+			return false
+		}
+	}
+	return true
+}
+
+// columnName returns the column a struct field maps to: its `sql` tag if
+// present, otherwise its name run through NameMapper.
+func columnName(field reflect.StructField) string {
+	if tag := field.Tag.Get(fieldTag); tag != "" {
+		return tag
+	}
+
+	return NameMapper(field.Name)
+}
+
+// fieldByIndex walks index into v, allocating nil pointers to embedded
+// structs as it goes. It's equivalent to reflect.Value.FieldByIndex except
+// for that allocation.
+func fieldByIndex(v reflect.Value, index []int) reflect.Value {
+	for i, x := range index {
+		if i > 0 && v.Kind() == reflect.Ptr {
+			if v.IsNil() {
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(x)
+	}
+
+	return v
+}
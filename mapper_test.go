@@ -0,0 +1,117 @@
+package gomodel
+
+import (
+	"database/sql"
+	"testing"
+)
+
+func TestScanEmbeddedStruct(t *testing.T) {
+	type Base struct {
+		ID int64
+	}
+
+	type model struct {
+		Base
+		Title string
+	}
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 2 {
+			t.Fatalf("want: %d dests, got: %d", 2, len(dests))
+		}
+
+		if dests[0] != &mymodel.ID {
+			t.Errorf("want: %v, got: %v", &mymodel.ID, dests[0])
+		}
+
+		if dests[1] != &mymodel.Title {
+			t.Errorf("want: %v, got: %v", &mymodel.Title, dests[1])
+		}
+
+		return nil
+	}
+
+	if err := Scan(scanner, []string{"id", "title"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestScanNestedStruct(t *testing.T) {
+	type Author struct {
+		Name string
+	}
+
+	type model struct {
+		Title  string
+		Author Author
+	}
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 2 {
+			t.Fatalf("want: %d dests, got: %d", 2, len(dests))
+		}
+
+		if dests[1] != &mymodel.Author.Name {
+			t.Errorf("want: %v, got: %v", &mymodel.Author.Name, dests[1])
+		}
+
+		return nil
+	}
+
+	if err := Scan(scanner, []string{"title", "author_name"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestScanSQLScannerFieldIsNotTraversed(t *testing.T) {
+	type model struct {
+		Name sql.NullString
+	}
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 1 {
+			t.Fatalf("want: %d dests, got: %d", 1, len(dests))
+		}
+
+		if dests[0] != &mymodel.Name {
+			t.Errorf("want: %v, got: %v", &mymodel.Name, dests[0])
+		}
+
+		return nil
+	}
+
+	if err := Scan(scanner, []string{"name"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestScanNameMapper(t *testing.T) {
+	old := NameMapper
+	defer func() { NameMapper = old }()
+
+	type model struct {
+		UnmappedField string
+	}
+
+	NameMapper = func(name string) string { return name }
+
+	mymodel := &model{}
+
+	scanner := func(dests ...interface{}) error {
+		if dests[0] != &mymodel.UnmappedField {
+			t.Errorf("want: %v, got: %v", &mymodel.UnmappedField, dests[0])
+		}
+
+		return nil
+	}
+
+	if err := Scan(scanner, []string{"UnmappedField"}, mymodel); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
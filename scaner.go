@@ -32,15 +32,38 @@ type ScannerFunc func(...interface{}) error
 
 // Scan maps the provided columns to pointers to fields on the provided model
 // and returns any errors encountered during scanning.
+//
+// model may also be a *map[string]interface{}, in which case each column is
+// scanned into an interface{} holder and assigned into the map under its
+// column name, for ad-hoc result sets with no corresponding struct.
+//
+// Unmapped columns are silently discarded; use ScanWithOptions with
+// Options.Strict to treat them as an error instead.
 func Scan(scanner ScannerFunc, columns []string, model interface{}) error {
-	var dests []interface{}
+	return ScanWithOptions(scanner, columns, model, Options{})
+}
 
-	mvalue := reflect.Indirect(reflect.ValueOf(model))
-	for _, column := range columns {
-		dests = append(dests, modelDest(mvalue, column))
+func scanMap(scanner ScannerFunc, columns []string, m *map[string]interface{}) error {
+	holders := make([]interface{}, len(columns))
+	dests := make([]interface{}, len(columns))
+	for i := range columns {
+		holders[i] = new(interface{})
+		dests[i] = holders[i]
 	}
 
-	return scanner(dests...)
+	if err := scanner(dests...); err != nil {
+		return err
+	}
+
+	if *m == nil {
+		*m = make(map[string]interface{}, len(columns))
+	}
+
+	for i, column := range columns {
+		(*m)[column] = *(holders[i].(*interface{}))
+	}
+
+	return nil
 }
 
 // Rows is the interface implemented by objects that scan an ordered set of data.
@@ -55,48 +78,28 @@ type Rows interface {
 // and appends a new T for each row, where models is []T.
 //
 // The provided models argument must be a pointer to a slice and have an
-// element type of either a struct or a pointer to a struct.
+// element type of either a struct or a pointer to a struct, or it may be a
+// *[]map[string]interface{} to scan into maps keyed by column name instead.
+//
+// Unmapped columns are silently discarded; use ScanRowsWithOptions with
+// Options.Strict to treat them as an error instead.
 func ScanRows(rows Rows, models interface{}) error {
-	// Check to ensure a pointer to an array was passed
-	if reflect.ValueOf(models).Kind() != reflect.Ptr {
-		return ErrNotPtr
-	}
-
-	mslice := reflect.Indirect(reflect.ValueOf(models))
-
-	if mslice.Kind() != reflect.Slice {
-		return ErrNotSlice
-	}
-
-	mType, err := discoverModelType(models)
-	if err != nil {
-		return err
-	}
+	return ScanRowsWithOptions(rows, models, Options{})
+}
 
-	// Get the list of columns returned in this row set
+func scanRowsMap(rows Rows, models *[]map[string]interface{}) error {
 	columns, err := rows.Columns()
 	if err != nil {
 		return err
 	}
 
-	// Loop over the rows as long as we can
 	for rows.Next() {
-		// Construct a new instance of the model typea. Analogous to:
-		// model := &ModelType{}
-		model := reflect.New(mType)
-
-		// Attempt to scan into an interface{} representation of the model.
-		// If there are any scan errors, return them
-		if err := Scan(rows.Scan, columns, model.Interface()); err != nil {
+		row := make(map[string]interface{}, len(columns))
+		if err := Scan(rows.Scan, columns, &row); err != nil {
 			return err
 		}
 
-		// Append the new model to the slice.  Analogous to:
-		// models = append(models, &model)
-		if mslice.Type().Elem().Kind() != reflect.Ptr {
-			model = reflect.Indirect(model)
-		}
-		mslice.Set(reflect.Append(mslice, model))
+		*models = append(*models, row)
 	}
 
 	return nil
@@ -117,32 +120,6 @@ func discoverModelType(models interface{}) (reflect.Type, error) {
 	return mType, nil
 }
 
-func modelDest(model reflect.Value, column string) interface{} {
-	mType := model.Type()
-
-	// Loop over the struct fields and look for one with a matching name
-	for i := 0; i < mType.NumField(); i++ {
-		fdef := mType.Field(i)
-
-		if fieldIsColumn(fdef, column) {
-			return model.Field(i).Addr().Interface()
-		}
-	}
-
-	// Unknown field -- discard the data
-	var discard interface{}
-	return &discard
-}
-
-func fieldIsColumn(field reflect.StructField, column string) bool {
-	// If the field has a tag, it should supercede the field name
-	if tag := field.Tag.Get(fieldTag); tag != "" {
-		return tag == column
-	}
-
-	return snake(field.Name) == column
-}
-
 func snake(input string) string {
 	return strings.ToLower(snakeRegexp.ReplaceAllString(input, "${1}_${2}"))
 }
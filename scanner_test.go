@@ -301,6 +301,78 @@ func TestScanRowsNonStruct(t *testing.T) {
 	t.Logf("Reported error: %s", err)
 }
 
+type interfaceRows struct {
+	currentRow int
+	columns    []string
+	data       [][]interface{}
+}
+
+func (r interfaceRows) Columns() ([]string, error) { return r.columns, nil }
+
+func (r *interfaceRows) Next() bool {
+	r.currentRow++
+	return r.currentRow <= len(r.data)
+}
+
+func (r interfaceRows) Scan(dests ...interface{}) error {
+	row := r.data[r.currentRow-1]
+
+	for i, src := range row {
+		*(dests[i].(*interface{})) = src
+	}
+
+	return nil
+}
+
+func TestScanMap(t *testing.T) {
+	scanner := func(dests ...interface{}) error {
+		if len(dests) != 2 {
+			t.Fatalf("want: %d dests, got: %d", 2, len(dests))
+		}
+
+		*(dests[0].(*interface{})) = int64(1234)
+		*(dests[1].(*interface{})) = "hello"
+
+		return nil
+	}
+
+	var row map[string]interface{}
+	if err := Scan(scanner, []string{"id", "title"}, &row); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if row["id"] != int64(1234) || row["title"] != "hello" {
+		t.Errorf("want: {id: 1234, title: hello}, got: %#v", row)
+	}
+}
+
+func TestScanRowsMap(t *testing.T) {
+	rows := &interfaceRows{
+		columns: []string{"id", "title"},
+		data: [][]interface{}{
+			{int64(1234), "hello"},
+			{int64(1235), "world"},
+		},
+	}
+
+	var results []map[string]interface{}
+	if err := ScanRows(rows, &results); err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(results) != 2 {
+		t.Fatalf("Expected %d results, got %d", 2, len(results))
+	}
+
+	if results[0]["id"] != int64(1234) || results[0]["title"] != "hello" {
+		t.Errorf("unexpected first result: %#v", results[0])
+	}
+
+	if results[1]["id"] != int64(1235) || results[1]["title"] != "world" {
+		t.Errorf("unexpected second result: %#v", results[1])
+	}
+}
+
 // Mocked SQL struct
 var db *sql.DB
 
@@ -0,0 +1,164 @@
+package gomodel
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Options configures optional strictness checks for ScanWithOptions and
+// ScanRowsWithOptions.
+type Options struct {
+	// Strict causes Scan to return an *UnmappedColumnsError instead of
+	// silently discarding result columns with no matching struct field.
+	Strict bool
+
+	// RequireAllFields causes Scan to return a *MissingColumnsError if any
+	// of the model's explicitly `sql`-tagged fields have no corresponding
+	// column in the result set.
+	RequireAllFields bool
+}
+
+var (
+	// ErrUnmappedColumn is wrapped by UnmappedColumnsError, returned in
+	// Options.Strict mode.
+	ErrUnmappedColumn = errors.New("gomodel: unmapped column")
+
+	// ErrMissingColumn is wrapped by MissingColumnsError, returned in
+	// Options.RequireAllFields mode.
+	ErrMissingColumn = errors.New("gomodel: missing column")
+)
+
+// UnmappedColumnsError reports every result column with no matching struct
+// field, found while scanning in Options.Strict mode.
+type UnmappedColumnsError struct {
+	Columns []string
+}
+
+func (e *UnmappedColumnsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrUnmappedColumn, strings.Join(e.Columns, ", "))
+}
+
+// Unwrap allows errors.Is(err, ErrUnmappedColumn) to succeed.
+func (e *UnmappedColumnsError) Unwrap() error { return ErrUnmappedColumn }
+
+// MissingColumnsError reports every tagged struct field with no matching
+// result column, found while scanning in Options.RequireAllFields mode.
+type MissingColumnsError struct {
+	Columns []string
+}
+
+func (e *MissingColumnsError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrMissingColumn, strings.Join(e.Columns, ", "))
+}
+
+// Unwrap allows errors.Is(err, ErrMissingColumn) to succeed.
+func (e *MissingColumnsError) Unwrap() error { return ErrMissingColumn }
+
+// ScanWithOptions behaves like Scan but applies opts. It has no effect on
+// map[string]interface{} destinations, which have no fixed set of fields to
+// check against.
+func ScanWithOptions(scanner ScannerFunc, columns []string, model interface{}, opts Options) error {
+	if m, ok := model.(*map[string]interface{}); ok {
+		return scanMap(scanner, columns, m)
+	}
+
+	mvalue := reflect.Indirect(reflect.ValueOf(model))
+	fields := fieldsFor(mvalue.Type())
+
+	dests := make([]interface{}, len(columns))
+	seen := make(map[string]bool, len(columns))
+	var unmapped []string
+
+	for i, column := range columns {
+		info, ok := fields[column]
+		if !ok {
+			if opts.Strict {
+				unmapped = append(unmapped, column)
+			}
+			var discard interface{}
+			dests[i] = &discard
+			continue
+		}
+
+		seen[column] = true
+
+		fieldVal := fieldByIndex(mvalue, info.index)
+		if conv, ok := converterFor(fieldVal.Type()); ok {
+			dests[i] = conv(fieldVal)
+		} else {
+			dests[i] = fieldVal.Addr().Interface()
+		}
+	}
+
+	if len(unmapped) > 0 {
+		return &UnmappedColumnsError{Columns: unmapped}
+	}
+
+	if opts.RequireAllFields {
+		if missing := missingColumns(fields, seen); len(missing) > 0 {
+			return &MissingColumnsError{Columns: missing}
+		}
+	}
+
+	return scanner(dests...)
+}
+
+// ScanRowsWithOptions behaves like ScanRows but applies opts to every row.
+func ScanRowsWithOptions(rows Rows, models interface{}, opts Options) error {
+	if maps, ok := models.(*[]map[string]interface{}); ok {
+		return scanRowsMap(rows, maps)
+	}
+
+	if reflect.ValueOf(models).Kind() != reflect.Ptr {
+		return ErrNotPtr
+	}
+
+	mslice := reflect.Indirect(reflect.ValueOf(models))
+
+	if mslice.Kind() != reflect.Slice {
+		return ErrNotSlice
+	}
+
+	mType, err := discoverModelType(models)
+	if err != nil {
+		return err
+	}
+
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+
+	for rows.Next() {
+		model := reflect.New(mType)
+
+		if err := ScanWithOptions(rows.Scan, columns, model.Interface(), opts); err != nil {
+			return err
+		}
+
+		if mslice.Type().Elem().Kind() != reflect.Ptr {
+			model = reflect.Indirect(model)
+		}
+		mslice.Set(reflect.Append(mslice, model))
+	}
+
+	return nil
+}
+
+// missingColumns returns the tagged columns in fields that aren't present
+// in seen, sorted for stable error messages.
+func missingColumns(fields fieldMap, seen map[string]bool) []string {
+	var missing []string
+
+	for column, info := range fields {
+		if info.tagged && !seen[column] {
+			missing = append(missing, column)
+		}
+	}
+
+	sort.Strings(missing)
+	return missing
+}
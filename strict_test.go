@@ -0,0 +1,100 @@
+package gomodel
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestScanWithOptionsStrictUnmappedColumn(t *testing.T) {
+	type model struct {
+		ID int64
+	}
+
+	mymodel := &model{}
+
+	err := ScanWithOptions(func(...interface{}) error { return nil }, []string{"id", "extra"}, mymodel, Options{Strict: true})
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	if !errors.Is(err, ErrUnmappedColumn) {
+		t.Errorf("want: errors.Is(err, ErrUnmappedColumn), got: %s", err)
+	}
+
+	var unmappedErr *UnmappedColumnsError
+	if !errors.As(err, &unmappedErr) {
+		t.Fatalf("want: *UnmappedColumnsError, got: %T", err)
+	}
+
+	if want := []string{"extra"}; !sliceEqual(unmappedErr.Columns, want) {
+		t.Errorf("want: %v, got: %v", want, unmappedErr.Columns)
+	}
+}
+
+func TestScanWithOptionsNonStrictIgnoresUnmappedColumn(t *testing.T) {
+	type model struct {
+		ID int64
+	}
+
+	mymodel := &model{}
+
+	err := ScanWithOptions(func(...interface{}) error { return nil }, []string{"id", "extra"}, mymodel, Options{})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func TestScanWithOptionsRequireAllFields(t *testing.T) {
+	type model struct {
+		ID    int64  `sql:"id"`
+		Title string `sql:"title"`
+	}
+
+	mymodel := &model{}
+
+	err := ScanWithOptions(func(...interface{}) error { return nil }, []string{"id"}, mymodel, Options{RequireAllFields: true})
+	if err == nil {
+		t.Fatalf("Expected an error, got nil")
+	}
+
+	if !errors.Is(err, ErrMissingColumn) {
+		t.Errorf("want: errors.Is(err, ErrMissingColumn), got: %s", err)
+	}
+
+	var missingErr *MissingColumnsError
+	if !errors.As(err, &missingErr) {
+		t.Fatalf("want: *MissingColumnsError, got: %T", err)
+	}
+
+	if want := []string{"title"}; !sliceEqual(missingErr.Columns, want) {
+		t.Errorf("want: %v, got: %v", want, missingErr.Columns)
+	}
+}
+
+func TestScanWithOptionsRequireAllFieldsIgnoresUntaggedFields(t *testing.T) {
+	type model struct {
+		ID   int64 `sql:"id"`
+		Name string
+	}
+
+	mymodel := &model{}
+
+	err := ScanWithOptions(func(...interface{}) error { return nil }, []string{"id"}, mymodel, Options{RequireAllFields: true})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+}
+
+func sliceEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+
+	return true
+}